@@ -0,0 +1,109 @@
+package v1alpha1
+
+import (
+	operatorv1alpha1 "github.com/openshift/api/operator/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Console is the configuration object for the console operator.  It governs
+// a single instance of the console running in TargetNamespace.
+type Console struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ConsoleSpec   `json:"spec"`
+	Status ConsoleStatus `json:"status,omitempty"`
+}
+
+// ConsoleSpec is the specification of the desired behavior of the Console.
+type ConsoleSpec struct {
+	operatorv1alpha1.OperatorSpec `json:",inline"`
+
+	// route lets a cluster admin expose the console on a hostname of their
+	// choosing, in addition to the operator-managed default route.
+	// +optional
+	Route ConsoleRouteSpec `json:"route,omitempty"`
+
+	// oauthSecretRotation configures when SyncSecret generates a fresh
+	// OAuthClient secret value. Defaults to OnMismatch.
+	// +optional
+	OAuthSecretRotation OAuthSecretRotationPolicy `json:"oauthSecretRotation,omitempty"`
+}
+
+// ConsoleRouteSpec configures an additional, custom-hostname Route for the console.
+type ConsoleRouteSpec struct {
+	// hostname is the desired custom hostname for the console route. Leave
+	// empty to serve the console only on the default in-cluster route.
+	// +optional
+	Hostname string `json:"hostname,omitempty"`
+
+	// secret points to a Secret, in the openshift-config namespace, of type
+	// kubernetes.io/tls containing the tls.crt/tls.key serving certificate
+	// for hostname.
+	// +optional
+	Secret *corev1.LocalObjectReference `json:"secret,omitempty"`
+}
+
+// OAuthSecretRotationType is the strategy the console operator uses to decide
+// when to generate a fresh OAuthClient secret value.
+type OAuthSecretRotationType string
+
+const (
+	// OAuthSecretRotationManual only rotates the secret's value when a
+	// cluster admin requests it, by setting the
+	// console.openshift.io/rotate-oauth-secret annotation to "true" on the
+	// console Secret.
+	OAuthSecretRotationManual OAuthSecretRotationType = "Manual"
+
+	// OAuthSecretRotationPeriodic rotates the secret's value automatically
+	// once Periodic.Duration has elapsed since it was last rotated.
+	OAuthSecretRotationPeriodic OAuthSecretRotationType = "Periodic"
+
+	// OAuthSecretRotationOnMismatch never generates a new secret value on its
+	// own; it relies on OAuthClientController detecting that the console
+	// Secret and OAuthClient secret have drifted and healing the OAuthClient
+	// to match the Secret. This is the default.
+	OAuthSecretRotationOnMismatch OAuthSecretRotationType = "OnMismatch"
+)
+
+// OAuthSecretRotationPolicy configures when SyncSecret generates a fresh
+// OAuthClient secret value.
+type OAuthSecretRotationPolicy struct {
+	// type selects the rotation strategy. Defaults to OnMismatch.
+	// +optional
+	Type OAuthSecretRotationType `json:"type,omitempty"`
+
+	// periodic configures the rotation interval when type is Periodic; it is
+	// ignored otherwise.
+	// +optional
+	Periodic *PeriodicRotationPolicy `json:"periodic,omitempty"`
+}
+
+// PeriodicRotationPolicy configures a fixed-interval rotation schedule.
+type PeriodicRotationPolicy struct {
+	// duration is how long a rotated secret is valid before it is rotated again.
+	Duration metav1.Duration `json:"duration"`
+}
+
+// ConsoleStatus defines the observed status of the Console.
+type ConsoleStatus struct {
+	operatorv1alpha1.OperatorStatus `json:",inline"`
+
+	// defaultHostName is the host name of the default (in-cluster) console route.
+	// +optional
+	DefaultHostName string `json:"defaultHostName,omitempty"`
+
+	// customHostName is the host name of the custom console route, when
+	// spec.route.hostname is set.
+	// +optional
+	CustomHostName string `json:"customHostName,omitempty"`
+}
+
+// ConsoleList is a list of Console resources.
+type ConsoleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []Console `json:"items"`
+}
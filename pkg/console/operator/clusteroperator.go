@@ -0,0 +1,115 @@
+package operator
+
+import (
+	"k8s.io/klog"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	configv1 "github.com/openshift/api/config/v1"
+	oauthv1 "github.com/openshift/api/oauth/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	configclientv1 "github.com/openshift/client-go/config/clientset/versioned/typed/config/v1"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	"github.com/openshift/console-operator/pkg/controller"
+)
+
+// clusterOperatorName is the name this operator reports itself under via
+// `oc get co`.
+const clusterOperatorName = "console"
+
+// conditionTypeToClusterOperator maps our aggregate Console conditions onto
+// the condition types ClusterOperator expects.
+var conditionTypeToClusterOperator = map[string]configv1.ClusterStatusConditionType{
+	ConditionAvailable:   configv1.OperatorAvailable,
+	ConditionProgressing: configv1.OperatorProgressing,
+	ConditionDegraded:    configv1.OperatorDegraded,
+	ConditionUpgradeable: configv1.OperatorUpgradeable,
+}
+
+// ClusterOperatorController mirrors the Console CR's aggregate conditions
+// onto the console ClusterOperator object, the interface cluster admins and
+// `oc get co` use to observe this operator's health.
+type ClusterOperatorController struct {
+	clusterOperatorClient configclientv1.ClusterOperatorsGetter
+	operatorImageVersion  string
+}
+
+// NewClusterOperatorController returns a ClusterOperatorController that reports
+// operatorImageVersion (the version this operator binary was built at) in
+// the ClusterOperator's status.versions.
+func NewClusterOperatorController(clusterOperatorClient configclientv1.ClusterOperatorsGetter, operatorImageVersion string) *ClusterOperatorController {
+	return &ClusterOperatorController{
+		clusterOperatorClient: clusterOperatorClient,
+		operatorImageVersion:  operatorImageVersion,
+	}
+}
+
+// Sync applies a ClusterOperator object whose conditions mirror consoleConfig.Status.Conditions
+// and whose relatedObjects reference the target namespace and each non-nil
+// subresource passed in. The console Service is not listed.
+func (c *ClusterOperatorController) Sync(consoleConfig *v1alpha1.Console, rt *routev1.Route, customRt *routev1.Route, dep *appsv1.Deployment, cm *corev1.ConfigMap, sec *corev1.Secret, oauthClient *oauthv1.OAuthClient) error {
+	co := &configv1.ClusterOperator{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterOperatorName,
+		},
+		Status: configv1.ClusterOperatorStatus{
+			Versions: []configv1.OperandVersion{
+				{Name: "operator", Version: c.operatorImageVersion},
+			},
+			RelatedObjects: relatedObjects(rt, customRt, dep, cm, sec, oauthClient),
+		},
+	}
+
+	for _, condition := range consoleConfig.Status.Conditions {
+		coConditionType, ok := conditionTypeToClusterOperator[condition.Type]
+		if !ok {
+			continue
+		}
+		co.Status.Conditions = append(co.Status.Conditions, configv1.ClusterOperatorStatusCondition{
+			Type:               coConditionType,
+			Status:             configv1.ConditionStatus(condition.Status),
+			Reason:             condition.Reason,
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime,
+		})
+	}
+
+	_, _, err := resourceapply.ApplyClusterOperatorStatus(c.clusterOperatorClient, co)
+	if err != nil {
+		klog.Errorf("clusteroperator: %v", err)
+	}
+	return err
+}
+
+// relatedObjects lists the target namespace and the routes, deployment,
+// configmap, secret and oauthclient this operator owns (those that are
+// non-nil), so that `oc adm must-gather` and support tooling know what to
+// collect for this operator. The console Service is not listed.
+func relatedObjects(rt *routev1.Route, customRt *routev1.Route, dep *appsv1.Deployment, cm *corev1.ConfigMap, sec *corev1.Secret, oauthClient *oauthv1.OAuthClient) []configv1.ObjectReference {
+	objects := []configv1.ObjectReference{
+		{Group: "", Resource: "namespaces", Name: controller.TargetNamespace},
+	}
+	if rt != nil {
+		objects = append(objects, configv1.ObjectReference{Group: "route.openshift.io", Resource: "routes", Namespace: controller.TargetNamespace, Name: rt.Name})
+	}
+	if customRt != nil {
+		objects = append(objects, configv1.ObjectReference{Group: "route.openshift.io", Resource: "routes", Namespace: controller.TargetNamespace, Name: customRt.Name})
+	}
+	if dep != nil {
+		objects = append(objects, configv1.ObjectReference{Group: "apps", Resource: "deployments", Namespace: controller.TargetNamespace, Name: dep.Name})
+	}
+	if cm != nil {
+		objects = append(objects, configv1.ObjectReference{Group: "", Resource: "configmaps", Namespace: controller.TargetNamespace, Name: cm.Name})
+	}
+	if sec != nil {
+		objects = append(objects, configv1.ObjectReference{Group: "", Resource: "secrets", Namespace: controller.TargetNamespace, Name: sec.Name})
+	}
+	if oauthClient != nil {
+		objects = append(objects, configv1.ObjectReference{Group: "oauth.openshift.io", Resource: "oauthclients", Name: oauthClient.Name})
+	}
+	return objects
+}
@@ -0,0 +1,58 @@
+package operator
+
+import (
+	operatorv1alpha1 "github.com/openshift/api/operator/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+)
+
+// controllerStatus is the per-controller result a sub-controller's Sync
+// produces for the top-level ConsoleOperatorController to fold into the
+// Console CR's conditions.
+type controllerStatus struct {
+	// conditionType is the OperatorCondition Type this status maps to, e.g. "RouteDegraded".
+	conditionType string
+	degraded      bool
+	reason        string
+	message       string
+}
+
+// setCondition creates or updates conditionType in status.Conditions, setting
+// its status, reason and message and bumping LastTransitionTime only when the
+// status actually changed. isTrue controls whether conditionType is recorded
+// as ConditionTrue or ConditionFalse.
+func setCondition(status *v1alpha1.ConsoleStatus, conditionType string, isTrue bool, reason, message string) {
+	newStatus := operatorv1alpha1.ConditionFalse
+	if isTrue {
+		newStatus = operatorv1alpha1.ConditionTrue
+	}
+
+	for i := range status.Conditions {
+		existing := &status.Conditions[i]
+		if existing.Type != conditionType {
+			continue
+		}
+		if existing.Status != newStatus {
+			existing.LastTransitionTime = metav1.Now()
+		}
+		existing.Status = newStatus
+		existing.Reason = reason
+		existing.Message = message
+		return
+	}
+
+	status.Conditions = append(status.Conditions, operatorv1alpha1.OperatorCondition{
+		Type:               conditionType,
+		Status:             newStatus,
+		Reason:             reason,
+		Message:            message,
+		LastTransitionTime: metav1.Now(),
+	})
+}
+
+// applyControllerStatus folds a single sub-controller's result into the
+// aggregate Console status.
+func applyControllerStatus(status *v1alpha1.ConsoleStatus, cs controllerStatus) {
+	setCondition(status, cs.conditionType, cs.degraded, cs.reason, cs.message)
+}
@@ -0,0 +1,41 @@
+package operator
+
+import (
+	"k8s.io/klog"
+
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	configmapsub "github.com/openshift/console-operator/pkg/console/subresource/configmap"
+)
+
+// ConfigMapController ensures the console's ConfigMap exists and reflects the
+// current Route. It takes the admitted Route as an input, supplied by
+// RouteController, and does not reconcile until that input is available.
+type ConfigMapController struct {
+	operator *ConsoleOperator
+}
+
+// NewConfigMapController returns a ConfigMapController bound to operator's configmap client.
+func NewConfigMapController(operator *ConsoleOperator) *ConfigMapController {
+	return &ConfigMapController{operator: operator}
+}
+
+// Sync reconciles the console ConfigMap against the given, already-admitted,
+// default Route and the optional custom Route (nil if none is configured).
+func (c *ConfigMapController) Sync(consoleConfig *v1alpha1.Console, rt *routev1.Route, customRt *routev1.Route) (*corev1.ConfigMap, bool, controllerStatus, error) {
+	cm, cmChanged, cmErr := resourceapply.ApplyConfigMap(c.operator.configMapClient, configmapsub.DefaultConfigMap(consoleConfig, rt, customRt))
+	if cmErr != nil {
+		klog.Errorf("configmap: %v", cmErr)
+		return nil, false, controllerStatus{
+			conditionType: ConditionConfigMapDegraded,
+			degraded:      true,
+			reason:        ReasonConfigMapInvalid,
+			message:       cmErr.Error(),
+		}, cmErr
+	}
+	return cm, cmChanged, controllerStatus{conditionType: ConditionConfigMapDegraded, degraded: false, reason: ReasonAsExpected}, nil
+}
@@ -0,0 +1,222 @@
+package operator
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/klog"
+
+	oauthv1 "github.com/openshift/api/oauth/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	"github.com/openshift/console-operator/pkg/steps"
+)
+
+// ConsoleOperatorController is the top-level controller for a Console CR. It
+// owns no subresources itself; instead it drives the per-resource
+// controllers, through a steps.Runner, in dependency order, and folds their
+// individual controllerStatus results into the Console's aggregate status.
+//
+// Steps run in this order: Route, custom Route, Service, ConfigMap, Secret,
+// OAuthClient, Deployment. Route, Service and Secret have no dependencies.
+// ConfigMap and OAuthClient both depend on Route (and, for OAuthClient, on
+// Secret). Deployment depends on ConfigMap and Secret and therefore syncs
+// last. Using steps.Runner here (rather than returning after each create, as
+// sync_v400 used to) lets a single reconcile carry on from one resource to
+// the next after creating it, instead of restarting from scratch. The run
+// still stops at the first step that fails after its retries; later steps
+// wait for the next reconcile.
+type ConsoleOperatorController struct {
+	route           *RouteController
+	service         *ServiceController
+	configMap       *ConfigMapController
+	secret          *SecretController
+	oauthClient     *OAuthClientController
+	deployment      *DeploymentController
+	clusterOperator *ClusterOperatorController
+	runner          *steps.Runner
+}
+
+// NewConsoleOperatorController wires up the per-resource controllers for operator,
+// reporting aggregate health via clusterOperator.
+func NewConsoleOperatorController(operator *ConsoleOperator, clusterOperator *ClusterOperatorController) *ConsoleOperatorController {
+	return &ConsoleOperatorController{
+		route:           NewRouteController(operator),
+		service:         NewServiceController(operator),
+		configMap:       NewConfigMapController(operator),
+		secret:          NewSecretController(operator),
+		oauthClient:     NewOAuthClientController(operator),
+		deployment:      NewDeploymentController(operator),
+		clusterOperator: clusterOperator,
+		runner:          &steps.Runner{},
+	}
+}
+
+// Sync drives every sub-controller for a single Console CR through an
+// ordered list of steps and returns the (possibly status-updated) Console,
+// whether anything changed, and the error from the step that stopped the
+// run, if any.
+func (c *ConsoleOperatorController) Sync(consoleConfig *v1alpha1.Console) (*v1alpha1.Console, bool, error) {
+	defer computeAggregateConditions(&consoleConfig.Status)
+
+	var (
+		toUpdate bool
+		rt       *routev1.Route
+		customRt *routev1.Route
+		cm       *corev1.ConfigMap
+		sec      *corev1.Secret
+		dep      *appsv1.Deployment
+		oauth    *oauthv1.OAuthClient
+	)
+
+	stepList := []steps.Step{
+		{
+			Name:    "ensureRoute",
+			Timeout: 30 * time.Second,
+			Action: func(ctx context.Context) error {
+				result, changed, status, err := c.route.EnsureRoute(consoleConfig)
+				applyControllerStatus(&consoleConfig.Status, status)
+				rt = result
+				toUpdate = toUpdate || changed
+				return err
+			},
+			Condition: func(ctx context.Context) (bool, bool, error) {
+				// waitForRouteHost: poll until the router has admitted a host,
+				// re-fetching the route each time in case another actor set it.
+				result, _, status, err := c.route.EnsureRoute(consoleConfig)
+				if err != nil {
+					applyControllerStatus(&consoleConfig.Status, status)
+					return false, false, err
+				}
+				rt = result
+				if rt.Spec.Host == "" {
+					applyControllerStatus(&consoleConfig.Status, controllerStatus{
+						conditionType: ConditionRouteDegraded,
+						degraded:      true,
+						reason:        ReasonRouteNotAdmitted,
+						message:       "waiting on Route.Spec.Host",
+					})
+					return false, true, nil
+				}
+				consoleConfig.Status.DefaultHostName = rt.Spec.Host
+				applyControllerStatus(&consoleConfig.Status, status)
+				return true, false, nil
+			},
+		},
+		{
+			Name: "ensureCustomRoute",
+			Action: func(ctx context.Context) error {
+				result, changed, status, err := c.route.EnsureCustomRoute(consoleConfig)
+				applyControllerStatus(&consoleConfig.Status, status)
+				customRt = result
+				if customRt != nil {
+					consoleConfig.Status.CustomHostName = customRt.Spec.Host
+				}
+				toUpdate = toUpdate || changed
+				// the custom route is optional and reports its own,
+				// non-aggregated condition: a missing or malformed serving
+				// certificate is a user-configuration problem that no retry
+				// will fix, so it must not stop the rest of the sync. An
+				// error actually creating/updating the Route itself, though,
+				// is exactly what the runner's conflict/backoff handling is
+				// for.
+				if status.reason == ReasonCustomRouteSecretMissing || status.reason == ReasonCustomRouteSecretInvalid {
+					return nil
+				}
+				return err
+			},
+		},
+		{
+			Name: "ensureService",
+			Action: func(ctx context.Context) error {
+				_, changed, status, err := c.service.Sync(consoleConfig)
+				applyControllerStatus(&consoleConfig.Status, status)
+				toUpdate = toUpdate || changed
+				return err
+			},
+		},
+		{
+			Name: "ensureConfigMap",
+			Action: func(ctx context.Context) error {
+				result, changed, status, err := c.configMap.Sync(consoleConfig, rt, customRt)
+				applyControllerStatus(&consoleConfig.Status, status)
+				cm = result
+				toUpdate = toUpdate || changed
+				return err
+			},
+		},
+		{
+			Name: "ensureSecret",
+			Action: func(ctx context.Context) error {
+				result, changed, status, err := c.secret.Sync(consoleConfig)
+				applyControllerStatus(&consoleConfig.Status, status)
+				sec = result
+				toUpdate = toUpdate || changed
+				return err
+			},
+		},
+		{
+			Name: "ensureOAuthClient",
+			Action: func(ctx context.Context) error {
+				result, changed, status, err := c.oauthClient.Sync(consoleConfig, sec, rt, customRt)
+				applyControllerStatus(&consoleConfig.Status, status)
+				oauth = result
+				toUpdate = toUpdate || changed
+				return err
+			},
+		},
+		{
+			Name:    "ensureDeployment",
+			Timeout: 30 * time.Second,
+			Action: func(ctx context.Context) error {
+				result, changed, status, err := c.deployment.Sync(consoleConfig, cm, sec)
+				applyControllerStatus(&consoleConfig.Status, status)
+				dep = result
+				toUpdate = toUpdate || changed
+				return err
+			},
+			Condition: func(ctx context.Context) (bool, bool, error) {
+				// waitForDeploymentAvailable: re-fetch so we observe replica status
+				// changes made by the deployment controller/kubelets, not our own stale copy.
+				current, getErr := c.deployment.Get()
+				if getErr != nil {
+					return false, false, getErr
+				}
+				dep = current
+				if deploymentAvailable(dep) {
+					return true, false, nil
+				}
+				return false, true, nil
+			},
+		},
+	}
+
+	failedStep, err := c.runner.Run(context.TODO(), stepList)
+	if err != nil {
+		klog.Errorf("console operator sync stopped at step %q: %v", failedStep, err)
+		return consoleConfig, toUpdate, err
+	}
+
+	if toUpdate {
+		klog.Infof("ConsoleOperatorController sync complete, updates made: %v", toUpdate)
+	}
+
+	if c.clusterOperator != nil {
+		if err := c.clusterOperator.Sync(consoleConfig, rt, customRt, dep, cm, sec, oauth); err != nil {
+			return consoleConfig, toUpdate, err
+		}
+	}
+
+	return consoleConfig, toUpdate, nil
+}
+
+// deploymentAvailable reports whether dep has at least one available replica.
+func deploymentAvailable(dep *appsv1.Deployment) bool {
+	if dep == nil {
+		return false
+	}
+	return dep.Status.AvailableReplicas > 0
+}
@@ -0,0 +1,101 @@
+package operator
+
+import (
+	"fmt"
+
+	"k8s.io/klog"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	operatorv1alpha1 "github.com/openshift/api/operator/v1alpha1"
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+	"github.com/openshift/library-go/pkg/operator/resource/resourcemerge"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	"github.com/openshift/console-operator/pkg/controller"
+	deploymentsub "github.com/openshift/console-operator/pkg/console/subresource/deployment"
+)
+
+// DeploymentController rolls out the console Deployment. It takes the
+// ConfigMap and Secret as inputs, supplied by ConfigMapController and
+// SecretController, and triggers a rollout whenever either changes.
+type DeploymentController struct {
+	operator *ConsoleOperator
+}
+
+// NewDeploymentController returns a DeploymentController bound to operator's deployment client.
+func NewDeploymentController(operator *ConsoleOperator) *DeploymentController {
+	return &DeploymentController{operator: operator}
+}
+
+// Get returns the current console Deployment without applying any changes,
+// for callers (e.g. a waitForDeploymentAvailable step) that only need to
+// observe its rollout status.
+func (c *DeploymentController) Get() (*appsv1.Deployment, error) {
+	return c.operator.deploymentClient.Deployments(controller.TargetNamespace).Get(deploymentsub.Stub().Name, metav1.GetOptions{})
+}
+
+// Sync reconciles the console Deployment against the given ConfigMap and Secret.
+func (c *DeploymentController) Sync(consoleConfig *v1alpha1.Console, cm *corev1.ConfigMap, sec *corev1.Secret) (*appsv1.Deployment, bool, controllerStatus, error) {
+	defaultDeployment := deploymentsub.DefaultDeployment(consoleConfig, cm, sec)
+	versionAvailability := &operatorv1alpha1.VersionAvailability{
+		Version: consoleConfig.Spec.Version,
+	}
+	deploymentGeneration := resourcemerge.ExpectedDeploymentGeneration(defaultDeployment, versionAvailability)
+
+	existingDeployment, getDepErr := c.operator.deploymentClient.Deployments(controller.TargetNamespace).Get(deploymentsub.Stub().Name, metav1.GetOptions{})
+
+	if apierrors.IsNotFound(getDepErr) {
+		klog.Info("deployment not found, creating new deployment")
+		createdDeployment, depCreated, createdErr := resourceapply.ApplyDeployment(c.operator.deploymentClient, defaultDeployment, deploymentGeneration, true)
+		if createdErr != nil {
+			wrappedErr := fmt.Errorf("deployment not found, creating new deployment, create error = %v", createdErr)
+			return nil, depCreated, controllerStatus{
+				conditionType: ConditionDeploymentDegraded,
+				degraded:      true,
+				reason:        ReasonDeploymentUnavailable,
+				message:       wrappedErr.Error(),
+			}, wrappedErr
+		}
+		c.operator.recorder.Eventf("DeploymentRolledOut", "Created Deployment %q", createdDeployment.Name)
+		// the deployment was just created; it has no available replicas yet, which
+		// the caller's waitForDeploymentAvailable step is expected to poll for.
+		return createdDeployment, depCreated, controllerStatus{
+			conditionType: ConditionDeploymentDegraded,
+			degraded:      true,
+			reason:        ReasonDeploymentUnavailable,
+			message:       "waiting for newly created deployment to become available",
+		}, nil
+	}
+
+	if getDepErr != nil {
+		klog.Errorf("deployment: %v", getDepErr)
+		return nil, false, controllerStatus{
+			conditionType: ConditionDeploymentDegraded,
+			degraded:      true,
+			reason:        ReasonSyncError,
+			message:       getDepErr.Error(),
+		}, getDepErr
+	}
+
+	if deploymentsub.ResourceVersionsChanged(existingDeployment, cm, sec) {
+		toUpdate := deploymentsub.UpdateResourceVersions(existingDeployment, cm, sec)
+		updatedDeployment, depChanged, updateErr := resourceapply.ApplyDeployment(c.operator.deploymentClient, toUpdate, deploymentGeneration, true)
+		if updateErr != nil {
+			klog.Errorf("deployment: %v", updateErr)
+			return nil, false, controllerStatus{
+				conditionType: ConditionDeploymentDegraded,
+				degraded:      true,
+				reason:        ReasonSyncError,
+				message:       updateErr.Error(),
+			}, updateErr
+		}
+		c.operator.recorder.Eventf("DeploymentRolledOut", "Updated Deployment %q for new ConfigMap/Secret resource versions", updatedDeployment.Name)
+		return updatedDeployment, depChanged, controllerStatus{conditionType: ConditionDeploymentDegraded, degraded: false, reason: ReasonAsExpected}, nil
+	}
+
+	return existingDeployment, false, controllerStatus{conditionType: ConditionDeploymentDegraded, degraded: false, reason: ReasonAsExpected}, nil
+}
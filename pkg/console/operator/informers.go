@@ -0,0 +1,33 @@
+package operator
+
+import (
+	kubeinformers "k8s.io/client-go/informers"
+
+	oauthinformers "github.com/openshift/client-go/oauth/informers/externalversions"
+	routeinformers "github.com/openshift/client-go/route/informers/externalversions"
+)
+
+// RegisterInformers adds c's EventHandler to every per-resource informer
+// this operator depends on: Service, ConfigMap, Secret, and Deployment from
+// kubeInformers, and Route/OAuthClient from their respective
+// openshift-generated factories. An Add/Update/Delete on any of them
+// enqueues the single Console key, the same way a direct Console CR change
+// does, so dependent resource changes drive a reconcile instead of waiting
+// on the queue's own resync interval.
+//
+// The Console CR itself is deliberately not registered here: this repo has
+// no generated informer/lister for v1alpha1.Console, only the hand-written
+// ConsolesGetter in this package, so it cannot be wired against a
+// SharedInformerFactory the way the resources above are. Until that codegen
+// exists, Console CR edits are only picked up by kubeInformers' resync, not
+// pushed the moment they happen; callers that need tighter turnaround should
+// also poll the Console CR directly.
+func (c *QueueController) RegisterInformers(kubeInformers kubeinformers.SharedInformerFactory, routeInformers routeinformers.SharedInformerFactory, oauthInformers oauthinformers.SharedInformerFactory) {
+	handler := c.EventHandler()
+	kubeInformers.Core().V1().Services().Informer().AddEventHandler(handler)
+	kubeInformers.Core().V1().ConfigMaps().Informer().AddEventHandler(handler)
+	kubeInformers.Core().V1().Secrets().Informer().AddEventHandler(handler)
+	kubeInformers.Apps().V1().Deployments().Informer().AddEventHandler(handler)
+	routeInformers.Route().V1().Routes().Informer().AddEventHandler(handler)
+	oauthInformers.Oauth().V1().OAuthClients().Informer().AddEventHandler(handler)
+}
@@ -0,0 +1,111 @@
+package operator
+
+import (
+	"errors"
+
+	"k8s.io/klog"
+
+	oauthv1 "github.com/openshift/api/oauth/v1"
+	operatorv1alpha1 "github.com/openshift/api/operator/v1alpha1"
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	oauthsub "github.com/openshift/console-operator/pkg/console/subresource/oauthclient"
+	secretsub "github.com/openshift/console-operator/pkg/console/subresource/secret"
+)
+
+// OAuthClientController keeps the console's OAuthClient redirect URIs and
+// secret in sync with the console Secret and Route. It should not be run
+// until both inputs are verified by SecretController and RouteController.
+type OAuthClientController struct {
+	operator *ConsoleOperator
+}
+
+// NewOAuthClientController returns an OAuthClientController bound to operator's oauth client.
+func NewOAuthClientController(operator *ConsoleOperator) *OAuthClientController {
+	return &OAuthClientController{operator: operator}
+}
+
+// Sync reconciles the OAuthClient against the given Secret and the default
+// and (optional) custom Route, registering a redirect URI for each hostname.
+func (c *OAuthClientController) Sync(consoleConfig *v1alpha1.Console, sec *corev1.Secret, rt *routev1.Route, customRt *routev1.Route) (*oauthv1.OAuthClient, bool, controllerStatus, error) {
+	oauthClient, err := c.operator.oauthClient.OAuthClients().Get(oauthsub.Stub().Name, metav1.GetOptions{})
+	if err != nil {
+		klog.Errorf("oauth: %v", err)
+		// at this point we must die & wait for someone to fix the lack of an oauthclient. there is nothing we can do.
+		missingErr := errors.New("oauth client for console does not exist")
+		return nil, false, controllerStatus{
+			conditionType: ConditionOAuthClientDegraded,
+			degraded:      true,
+			reason:        ReasonOAuthClientMissing,
+			message:       missingErr.Error(),
+		}, missingErr
+	}
+	// Judge drift against the secret as it is actually persisted on the
+	// server, before any local mutation below has a chance to paper over it:
+	// an OAuthClient that has never had a secret set isn't "drifted", it's
+	// simply unregistered yet.
+	consoleSecret := secretsub.GetSecretString(sec)
+	drifted := oauthsub.GetSecretString(oauthClient) != "" && !secretAndOauthMatch(sec, oauthClient)
+
+	oauthsub.RegisterConsoleRedirectURIs(oauthClient, rt, customRt)
+	if !drifted {
+		oauthClient.Secret = consoleSecret
+	}
+	oauthClient, oauthChanged, oauthErr := oauthsub.ApplyOAuth(c.operator.oauthClient, oauthClient)
+	if oauthErr != nil {
+		klog.Errorf("oauth: %v", oauthErr)
+		return nil, false, controllerStatus{
+			conditionType: ConditionOAuthClientDegraded,
+			degraded:      true,
+			reason:        ReasonSyncError,
+			message:       oauthErr.Error(),
+		}, oauthErr
+	}
+	if oauthChanged {
+		c.operator.recorder.Eventf("OAuthClientRegistered", "Updated OAuthClient %q redirect URIs and secret", oauthClient.Name)
+	}
+	if drifted {
+		// the console Secret is the source of truth: heal the drift by
+		// overwriting the OAuthClient's secret to match it, rather than just
+		// reporting the mismatch and leaving the console unable to
+		// authenticate users until the next manual intervention.
+		klog.Infof("oauth: OAuthClient %q secret has drifted from the console Secret, healing", oauthClient.Name)
+		oauthClient.Secret = consoleSecret
+		healed, healChanged, healErr := oauthsub.ApplyOAuth(c.operator.oauthClient, oauthClient)
+		if healErr != nil {
+			klog.Errorf("oauth: %v", healErr)
+			return oauthClient, oauthChanged, controllerStatus{
+				conditionType: ConditionSecretOAuthDrift,
+				degraded:      true,
+				reason:        ReasonSyncError,
+				message:       healErr.Error(),
+			}, healErr
+		}
+		c.operator.recorder.Eventf("SecretRotated", "Healed OAuthClient %q secret to match the console Secret after drift was detected", healed.Name)
+		return healed, oauthChanged || healChanged, controllerStatus{
+			conditionType: ConditionSecretOAuthDrift,
+			degraded:      false,
+			reason:        ReasonDriftHealed,
+			message:       "the console Secret and OAuthClient secret had drifted; healed by overwriting the OAuthClient secret",
+		}, nil
+	}
+
+	// A ConditionSecretOAuthDrift left degraded by an earlier failed heal is
+	// cleared here, since the drift it reported no longer exists; otherwise it
+	// would keep the aggregate Degraded condition true.
+	for _, cond := range consoleConfig.Status.Conditions {
+		if cond.Type == ConditionSecretOAuthDrift && cond.Status == operatorv1alpha1.ConditionTrue {
+			setCondition(&consoleConfig.Status, ConditionSecretOAuthDrift, false, ReasonAsExpected, "")
+		}
+	}
+
+	// Report via ConditionOAuthClientDegraded here: this condition tracks
+	// whether the OAuthClient sync itself succeeded and must be explicitly
+	// cleared on every healthy reconcile, or an earlier
+	// ReasonOAuthClientMissing/ReasonSyncError would latch degraded=true
+	// forever.
+	return oauthClient, oauthChanged, controllerStatus{conditionType: ConditionOAuthClientDegraded, degraded: false, reason: ReasonAsExpected}, nil
+}
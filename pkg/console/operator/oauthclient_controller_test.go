@@ -0,0 +1,109 @@
+package operator
+
+import (
+	"errors"
+	"testing"
+
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8stesting "k8s.io/client-go/testing"
+
+	"github.com/openshift/library-go/pkg/operator/events"
+
+	oauthfake "github.com/openshift/client-go/oauth/clientset/versioned/fake"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	oauthsub "github.com/openshift/console-operator/pkg/console/subresource/oauthclient"
+	secretsub "github.com/openshift/console-operator/pkg/console/subresource/secret"
+)
+
+func newTestOAuthClientController(objects ...runtime.Object) (*OAuthClientController, *oauthfake.Clientset) {
+	client := oauthfake.NewSimpleClientset(objects...)
+	operator := NewConsoleOperator(nil, nil, nil, nil, nil, client.OauthV1(), events.NewInMemoryRecorder("test"))
+	return NewOAuthClientController(operator), client
+}
+
+func consoleSecret(value string) *corev1.Secret {
+	return secretsub.DefaultSecret(&v1alpha1.Console{}, value)
+}
+
+func TestOAuthClientController_Sync_InitialRegistration(t *testing.T) {
+	existing := oauthsub.Stub()
+	rt := &routev1.Route{Spec: routev1.RouteSpec{Host: "console.example.com"}}
+
+	controller, _ := newTestOAuthClientController(existing)
+	client, changed, status, err := controller.Sync(&v1alpha1.Console{}, consoleSecret("s3cr3t"), rt, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !changed {
+		t.Fatal("expected the freshly-registered OAuthClient to be reported as changed")
+	}
+	if client.Secret != "s3cr3t" {
+		t.Errorf("expected OAuthClient secret %q, got %q", "s3cr3t", client.Secret)
+	}
+	if status.conditionType != ConditionOAuthClientDegraded || status.degraded || status.reason != ReasonAsExpected {
+		t.Errorf("expected a healthy OAuthClientDegraded status for first-time registration, got %#v", status)
+	}
+}
+
+func TestOAuthClientController_Sync_NoDriftLeavesSecretUntouched(t *testing.T) {
+	existing := oauthsub.Stub()
+	existing.Secret = "s3cr3t"
+	rt := &routev1.Route{Spec: routev1.RouteSpec{Host: "console.example.com"}}
+
+	controller, _ := newTestOAuthClientController(existing)
+	client, _, status, err := controller.Sync(&v1alpha1.Console{}, consoleSecret("s3cr3t"), rt, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if client.Secret != "s3cr3t" {
+		t.Errorf("expected matching secret to be left alone, got %q", client.Secret)
+	}
+	if status.reason != ReasonAsExpected || status.degraded {
+		t.Errorf("expected no drift reported, got %#v", status)
+	}
+}
+
+func TestOAuthClientController_Sync_DetectsAndHealsDrift(t *testing.T) {
+	existing := oauthsub.Stub()
+	existing.Secret = "stale-secret"
+	rt := &routev1.Route{Spec: routev1.RouteSpec{Host: "console.example.com"}}
+
+	controller, _ := newTestOAuthClientController(existing)
+	healed, changed, status, err := controller.Sync(&v1alpha1.Console{}, consoleSecret("fresh-secret"), rt, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !changed {
+		t.Fatal("expected healing the drifted secret to be reported as a change")
+	}
+	if healed.Secret != "fresh-secret" {
+		t.Errorf("expected the drifted OAuthClient secret to be healed to %q, got %q", "fresh-secret", healed.Secret)
+	}
+	if status.conditionType != ConditionSecretOAuthDrift || status.degraded || status.reason != ReasonDriftHealed {
+		t.Errorf("expected a healed SecretOAuthDrift status, got %#v", status)
+	}
+}
+
+func TestOAuthClientController_Sync_ConflictOnUpdateIsSurfacedForRetry(t *testing.T) {
+	existing := oauthsub.Stub()
+	existing.Secret = "stale-secret"
+	rt := &routev1.Route{Spec: routev1.RouteSpec{Host: "console.example.com"}}
+
+	controller, client := newTestOAuthClientController(existing)
+	client.PrependReactor("update", "oauthclients", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "oauthclients"}, existing.Name, errors.New("conflict"))
+	})
+
+	_, _, _, err := controller.Sync(&v1alpha1.Console{}, consoleSecret("fresh-secret"), rt, nil)
+	if err == nil {
+		t.Fatal("expected the conflicting Update to surface an error")
+	}
+	if !apierrors.IsConflict(err) {
+		t.Errorf("expected an apierrors.IsConflict error so the steps.Runner can retry it, got %v", err)
+	}
+}
@@ -0,0 +1,46 @@
+package operator
+
+import (
+	appsclientv1 "k8s.io/client-go/kubernetes/typed/apps/v1"
+	coreclientv1 "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	oauthclientv1 "github.com/openshift/client-go/oauth/clientset/versioned/typed/oauth/v1"
+	routeclientv1 "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
+	"github.com/openshift/library-go/pkg/operator/events"
+)
+
+// ConsoleOperator holds the clients the console operator's controllers use to
+// reconcile the subresources that make up a running console.
+type ConsoleOperator struct {
+	deploymentClient appsclientv1.DeploymentsGetter
+	secretsClient    coreclientv1.SecretsGetter
+	configMapClient  coreclientv1.ConfigMapsGetter
+	serviceClient    coreclientv1.ServicesGetter
+	routeClient      routeclientv1.RoutesGetter
+	oauthClient      oauthclientv1.OAuthClientsGetter
+	recorder         events.Recorder
+}
+
+// NewConsoleOperator wires up a ConsoleOperator from the individual typed
+// clients its controllers depend on. recorder is used by the per-resource
+// controllers to emit Kubernetes Events on the Console CR for notable state
+// transitions (e.g. RouteCreated, OAuthClientRegistered).
+func NewConsoleOperator(
+	deploymentClient appsclientv1.DeploymentsGetter,
+	secretsClient coreclientv1.SecretsGetter,
+	configMapClient coreclientv1.ConfigMapsGetter,
+	serviceClient coreclientv1.ServicesGetter,
+	routeClient routeclientv1.RoutesGetter,
+	oauthClient oauthclientv1.OAuthClientsGetter,
+	recorder events.Recorder,
+) *ConsoleOperator {
+	return &ConsoleOperator{
+		deploymentClient: deploymentClient,
+		secretsClient:    secretsClient,
+		configMapClient:  configMapClient,
+		serviceClient:    serviceClient,
+		routeClient:      routeClient,
+		oauthClient:      oauthClient,
+		recorder:         recorder,
+	}
+}
@@ -0,0 +1,147 @@
+package operator
+
+import (
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	"github.com/openshift/console-operator/pkg/controller"
+)
+
+// ConsolesGetter is satisfied by the generated typed client for the Console
+// CR. It is declared locally, mirroring the Getter interfaces ConsoleOperator
+// already holds for the other subresources, since this chunk does not vendor
+// a generated clientset for v1alpha1.Console.
+type ConsolesGetter interface {
+	Consoles() ConsoleInterface
+}
+
+// ConsoleInterface is the subset of the generated Console client QueueController needs.
+type ConsoleInterface interface {
+	Get(name string, options metav1.GetOptions) (*v1alpha1.Console, error)
+	UpdateStatus(console *v1alpha1.Console) (*v1alpha1.Console, error)
+}
+
+// QueueController drives ConsoleOperatorController.Sync off a rate-limited
+// workqueue fed by shared informers, instead of a fixed-interval tick. There
+// is only ever one Console to reconcile, so every informer this operator
+// watches (Route, Service, ConfigMap, Secret, OAuthClient, and Deployment,
+// registered via RegisterInformers) enqueues the same key on any
+// Add/Update/Delete.
+//
+// This is a single shared queue feeding the existing per-resource Sync
+// methods through ConsoleOperatorController, not one informer/queue pair per
+// controller. The per-resource controllers still run in the fixed order
+// ConsoleOperatorController.Sync (via steps.Runner) calls them in, so
+// splitting the queue itself per-controller would not let them reconcile
+// independently anyway without first reworking that ordering dependency.
+// That rewrite is out of scope here; raise it as its own request if
+// per-controller queues are actually needed.
+type QueueController struct {
+	operatorController *ConsoleOperatorController
+	consoleClient       ConsolesGetter
+	queue               workqueue.RateLimitingInterface
+}
+
+// NewQueueController returns a QueueController that reconciles
+// controller.TargetName through operatorController whenever consoleClient's
+// object, or any watched subresource, changes. Non-conflict sync errors are
+// requeued with exponential backoff; conflicts are requeued immediately,
+// since they are expected whenever another actor updates the same object
+// concurrently and resolve themselves on the next Get.
+func NewQueueController(operatorController *ConsoleOperatorController, consoleClient ConsolesGetter) *QueueController {
+	return &QueueController{
+		operatorController: operatorController,
+		consoleClient:      consoleClient,
+		queue: workqueue.NewNamedRateLimitingQueue(
+			workqueue.NewItemExponentialFailureRateLimiter(100*time.Millisecond, 30*time.Second),
+			"console-operator",
+		),
+	}
+}
+
+// EventHandler returns a cache.ResourceEventHandler that enqueues the single
+// Console key on any event; register it against every shared informer this
+// operator watches.
+func (c *QueueController) EventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc:    func(obj interface{}) { c.enqueue() },
+		UpdateFunc: func(oldObj, newObj interface{}) { c.enqueue() },
+		DeleteFunc: func(obj interface{}) { c.enqueue() },
+	}
+}
+
+func (c *QueueController) enqueue() {
+	c.queue.Add(controller.TargetName)
+}
+
+// Run starts the given number of worker goroutines, each draining the queue
+// until stopCh is closed.
+func (c *QueueController) Run(workers int, stopCh <-chan struct{}) {
+	defer c.queue.ShutDown()
+
+	klog.Infof("starting console operator queue controller with %d workers", workers)
+	for i := 0; i < workers; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	klog.Info("shutting down console operator queue controller")
+}
+
+func (c *QueueController) runWorker() {
+	for c.processNextWorkItem() {
+	}
+}
+
+func (c *QueueController) processNextWorkItem() bool {
+	key, shutdown := c.queue.Get()
+	if shutdown {
+		return false
+	}
+	defer c.queue.Done(key)
+
+	err := c.sync(key.(string))
+	switch {
+	case err == nil:
+		c.queue.Forget(key)
+	case apierrors.IsConflict(err):
+		klog.V(4).Infof("console operator sync conflict for %q, requeueing immediately: %v", key, err)
+		c.queue.Forget(key)
+		c.queue.Add(key)
+	default:
+		klog.Errorf("console operator sync failed for %q: %v", key, err)
+		c.queue.AddRateLimited(key)
+	}
+	return true
+}
+
+// sync fetches the named Console, runs it through operatorController, and
+// persists any status changes the run produced.
+func (c *QueueController) sync(name string) error {
+	consoleConfig, err := c.consoleClient.Consoles().Get(name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		klog.V(4).Infof("console %q not found, nothing to sync", name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	originalStatus := consoleConfig.Status.DeepCopy()
+	updated, changed, syncErr := c.operatorController.Sync(consoleConfig)
+	if !changed && equality.Semantic.DeepEqual(originalStatus, &updated.Status) {
+		return syncErr
+	}
+	if _, updateErr := c.consoleClient.Consoles().UpdateStatus(updated); updateErr != nil {
+		return updateErr
+	}
+	return syncErr
+}
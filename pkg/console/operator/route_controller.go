@@ -0,0 +1,122 @@
+package operator
+
+import (
+	"errors"
+
+	"k8s.io/klog"
+
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	"github.com/openshift/console-operator/pkg/controller"
+	routesub "github.com/openshift/console-operator/pkg/console/subresource/route"
+)
+
+// RouteController ensures the console's Route exists and has been admitted a
+// host by the router before anything that depends on it (ConfigMap, OAuthClient)
+// is allowed to proceed.
+type RouteController struct {
+	operator *ConsoleOperator
+}
+
+// NewRouteController returns a RouteController bound to operator's route client.
+func NewRouteController(operator *ConsoleOperator) *RouteController {
+	return &RouteController{operator: operator}
+}
+
+// EnsureRoute creates or fetches the console Route, without waiting for it to
+// be admitted a host. Callers that need an admitted host should follow this
+// with a poll against the returned Route's Spec.Host, e.g. via Sync or a
+// steps.Step Condition.
+func (c *RouteController) EnsureRoute(consoleConfig *v1alpha1.Console) (*routev1.Route, bool, controllerStatus, error) {
+	rt, rtIsNew, rtErr := routesub.GetOrCreate(c.operator.routeClient, routesub.DefaultRoute(consoleConfig))
+	if rtErr != nil {
+		klog.Errorf("route: %v", rtErr)
+		return nil, false, controllerStatus{
+			conditionType: ConditionRouteDegraded,
+			degraded:      true,
+			reason:        ReasonSyncError,
+			message:       rtErr.Error(),
+		}, rtErr
+	}
+	if rtIsNew {
+		c.operator.recorder.Eventf("RouteCreated", "Created Route %q", rt.Name)
+	}
+	return rt, rtIsNew, controllerStatus{conditionType: ConditionRouteDegraded, degraded: false, reason: ReasonAsExpected}, nil
+}
+
+// Sync reconciles the console Route, returning the route once it has been
+// admitted a host, and a controllerStatus describing RouteDegraded.
+func (c *RouteController) Sync(consoleConfig *v1alpha1.Console) (*routev1.Route, bool, controllerStatus, error) {
+	rt, rtIsNew, status, err := c.EnsureRoute(consoleConfig)
+	if err != nil {
+		return nil, false, status, err
+	}
+
+	// we will not proceed until the route is valid. this eliminates complexity with the
+	// configmap, secret & oauth client as they can be certain they have a host if we pass this point.
+	if len(rt.Spec.Host) == 0 {
+		err := errors.New("waiting on Route.Spec.Host")
+		return nil, false, controllerStatus{
+			conditionType: ConditionRouteDegraded,
+			degraded:      true,
+			reason:        ReasonRouteNotAdmitted,
+			message:       err.Error(),
+		}, err
+	}
+
+	return rt, rtIsNew, status, nil
+}
+
+// EnsureCustomRoute creates or fetches the optional, custom-hostname Route
+// described by consoleConfig.Spec.Route. It returns (nil, false, <healthy
+// status>, nil) when no custom hostname is configured, so callers can treat
+// "not configured" and "configured and in sync" the same way.
+func (c *RouteController) EnsureCustomRoute(consoleConfig *v1alpha1.Console) (*routev1.Route, bool, controllerStatus, error) {
+	if consoleConfig.Spec.Route.Hostname == "" {
+		return nil, false, controllerStatus{conditionType: ConditionCustomRouteDegraded, degraded: false, reason: ReasonAsExpected}, nil
+	}
+
+	secretName := ""
+	if consoleConfig.Spec.Route.Secret != nil {
+		secretName = consoleConfig.Spec.Route.Secret.Name
+	}
+	tlsSecret, secErr := c.operator.secretsClient.Secrets(controller.OpenShiftConfigNamespace).Get(secretName, metav1.GetOptions{})
+	if secErr != nil {
+		klog.Errorf("custom route: %v", secErr)
+		return nil, false, controllerStatus{
+			conditionType: ConditionCustomRouteDegraded,
+			degraded:      true,
+			reason:        ReasonCustomRouteSecretMissing,
+			message:       secErr.Error(),
+		}, secErr
+	}
+	if validateErr := routesub.ValidateServingCertSecret(tlsSecret); validateErr != nil {
+		klog.Errorf("custom route: %v", validateErr)
+		return nil, false, controllerStatus{
+			conditionType: ConditionCustomRouteDegraded,
+			degraded:      true,
+			reason:        ReasonCustomRouteSecretInvalid,
+			message:       validateErr.Error(),
+		}, validateErr
+	}
+
+	rt, rtChanged, rtErr := routesub.ApplyRoute(c.operator.routeClient, routesub.DefaultCustomRoute(consoleConfig, tlsSecret))
+	if rtErr != nil {
+		klog.Errorf("custom route: %v", rtErr)
+		return nil, false, controllerStatus{
+			conditionType: ConditionCustomRouteDegraded,
+			degraded:      true,
+			reason:        ReasonSyncError,
+			message:       rtErr.Error(),
+		}, rtErr
+	}
+	if rtChanged {
+		// ApplyRoute covers both first creation and later updates (e.g. a
+		// rotated TLS secret producing a new certificate/key), so one event
+		// covers both.
+		c.operator.recorder.Eventf("CustomRouteUpdated", "Reconciled custom Route %q", rt.Name)
+	}
+	return rt, rtChanged, controllerStatus{conditionType: ConditionCustomRouteDegraded, degraded: false, reason: ReasonAsExpected}, nil
+}
@@ -0,0 +1,81 @@
+package operator
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/klog"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	"github.com/openshift/console-operator/pkg/controller"
+	"github.com/openshift/console-operator/pkg/crypto"
+	secretsub "github.com/openshift/console-operator/pkg/console/subresource/secret"
+)
+
+// SecretController ensures the console's session Secret exists and carries a
+// non-empty value. It has no dependency on the other controllers.
+type SecretController struct {
+	operator *ConsoleOperator
+}
+
+// NewSecretController returns a SecretController bound to operator's secrets client.
+func NewSecretController(operator *ConsoleOperator) *SecretController {
+	return &SecretController{operator: operator}
+}
+
+// Sync reconciles the console Secret: creating one with a fresh random value
+// if it is missing or empty, and otherwise rotating its value when
+// consoleConfig.Spec.OAuthSecretRotation calls for it (a Manual rotation
+// request via secretsub.RotateAnnotation, or a Periodic interval elapsing).
+func (c *SecretController) Sync(consoleConfig *v1alpha1.Console) (*corev1.Secret, bool, controllerStatus, error) {
+	secret, err := c.operator.secretsClient.Secrets(controller.TargetNamespace).Get(secretsub.Stub().Name, metav1.GetOptions{})
+	if err != nil && !apierrors.IsNotFound(err) {
+		klog.Errorf("secret: %v", err)
+		return nil, false, controllerStatus{
+			conditionType: ConditionSecretDegraded,
+			degraded:      true,
+			reason:        ReasonSyncError,
+			message:       err.Error(),
+		}, err
+	}
+	if apierrors.IsNotFound(err) || secretsub.GetSecretString(secret) == "" {
+		created, secChanged, secErr := resourceapply.ApplySecret(c.operator.secretsClient, secretsub.DefaultSecret(consoleConfig, crypto.Random256BitsString()))
+		if secErr != nil {
+			createErr := fmt.Errorf("secret not found, creating new secret, create error = %v", secErr)
+			return nil, secChanged, controllerStatus{
+				conditionType: ConditionSecretDegraded,
+				degraded:      true,
+				reason:        ReasonSecretMissing,
+				message:       createErr.Error(),
+			}, createErr
+		}
+		c.operator.recorder.Eventf("SecretRotated", "Created Secret %q with a new random value", created.Name)
+		return created, secChanged, controllerStatus{conditionType: ConditionSecretDegraded, degraded: false, reason: ReasonAsExpected}, nil
+	}
+
+	if secretsub.ShouldRotate(secret, consoleConfig.Spec.OAuthSecretRotation, time.Now()) {
+		// Update the fetched Secret as a whole, so that the RotateAnnotation
+		// MarkRotated removed is removed on the server too; ApplySecret only
+		// merges annotations in and would leave it set.
+		rotated, rotateErr := c.operator.secretsClient.Secrets(controller.TargetNamespace).Update(secretsub.MarkRotated(secret, crypto.Random256BitsString(), time.Now()))
+		if rotateErr != nil {
+			klog.Errorf("secret: %v", rotateErr)
+			return nil, false, controllerStatus{
+				conditionType: ConditionSecretDegraded,
+				degraded:      true,
+				reason:        ReasonSyncError,
+				message:       rotateErr.Error(),
+			}, rotateErr
+		}
+		c.operator.recorder.Eventf("SecretRotated", "Rotated Secret %q per %s rotation policy", rotated.Name, consoleConfig.Spec.OAuthSecretRotation.Type)
+		return rotated, true, controllerStatus{conditionType: ConditionSecretDegraded, degraded: false, reason: ReasonAsExpected}, nil
+	}
+
+	return secret, false, controllerStatus{conditionType: ConditionSecretDegraded, degraded: false, reason: ReasonAsExpected}, nil
+}
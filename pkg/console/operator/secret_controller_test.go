@@ -0,0 +1,116 @@
+package operator
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/openshift/library-go/pkg/operator/events"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	secretsub "github.com/openshift/console-operator/pkg/console/subresource/secret"
+)
+
+func newTestSecretController(objects ...runtime.Object) (*SecretController, *k8sfake.Clientset) {
+	client := k8sfake.NewSimpleClientset(objects...)
+	operator := NewConsoleOperator(nil, client.CoreV1(), nil, nil, nil, nil, events.NewInMemoryRecorder("test"))
+	return NewSecretController(operator), client
+}
+
+func TestSecretController_Sync_PeriodicRotationDue(t *testing.T) {
+	existing := secretsub.DefaultSecret(&v1alpha1.Console{}, "stale-value")
+	existing.Annotations = map[string]string{
+		secretsub.LastRotatedAnnotation: time.Now().Add(-2 * time.Hour).Format(time.RFC3339),
+	}
+	consoleConfig := &v1alpha1.Console{Spec: v1alpha1.ConsoleSpec{
+		OAuthSecretRotation: v1alpha1.OAuthSecretRotationPolicy{
+			Type:     v1alpha1.OAuthSecretRotationPeriodic,
+			Periodic: &v1alpha1.PeriodicRotationPolicy{Duration: metav1.Duration{Duration: time.Hour}},
+		},
+	}}
+
+	controller, _ := newTestSecretController(existing)
+	secret, changed, status, err := controller.Sync(consoleConfig)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !changed {
+		t.Fatal("expected a secret past its periodic interval to rotate")
+	}
+	if secretsub.GetSecretString(secret) == "stale-value" {
+		t.Error("expected the secret value to change on periodic rotation")
+	}
+	if status.reason != ReasonAsExpected || status.degraded {
+		t.Errorf("expected a healthy status after rotation, got %#v", status)
+	}
+}
+
+func TestSecretController_Sync_PeriodicRotationNotYetDue(t *testing.T) {
+	existing := secretsub.DefaultSecret(&v1alpha1.Console{}, "current-value")
+	existing.Annotations = map[string]string{
+		secretsub.LastRotatedAnnotation: time.Now().Add(-5 * time.Minute).Format(time.RFC3339),
+	}
+	consoleConfig := &v1alpha1.Console{Spec: v1alpha1.ConsoleSpec{
+		OAuthSecretRotation: v1alpha1.OAuthSecretRotationPolicy{
+			Type:     v1alpha1.OAuthSecretRotationPeriodic,
+			Periodic: &v1alpha1.PeriodicRotationPolicy{Duration: metav1.Duration{Duration: time.Hour}},
+		},
+	}}
+
+	controller, _ := newTestSecretController(existing)
+	secret, changed, _, err := controller.Sync(consoleConfig)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if changed {
+		t.Fatal("expected no rotation before the periodic interval has elapsed")
+	}
+	if secretsub.GetSecretString(secret) != "current-value" {
+		t.Errorf("expected the secret value to be left alone, got %q", secretsub.GetSecretString(secret))
+	}
+}
+
+func TestSecretController_Sync_ManualRotationViaAnnotation(t *testing.T) {
+	existing := secretsub.DefaultSecret(&v1alpha1.Console{}, "current-value")
+	existing.Annotations = map[string]string{secretsub.RotateAnnotation: "true"}
+	consoleConfig := &v1alpha1.Console{Spec: v1alpha1.ConsoleSpec{
+		OAuthSecretRotation: v1alpha1.OAuthSecretRotationPolicy{Type: v1alpha1.OAuthSecretRotationManual},
+	}}
+
+	controller, _ := newTestSecretController(existing)
+	secret, changed, _, err := controller.Sync(consoleConfig)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !changed {
+		t.Fatal("expected the rotate annotation to trigger a rotation")
+	}
+	if secretsub.GetSecretString(secret) == "current-value" {
+		t.Error("expected the secret value to change on manual rotation")
+	}
+	if _, stillSet := secret.Annotations[secretsub.RotateAnnotation]; stillSet {
+		t.Error("expected the rotate-request annotation to be cleared once the rotation is applied")
+	}
+}
+
+func TestSecretController_Sync_ManualPolicyWithoutAnnotationDoesNotRotate(t *testing.T) {
+	existing := secretsub.DefaultSecret(&v1alpha1.Console{}, "current-value")
+	consoleConfig := &v1alpha1.Console{Spec: v1alpha1.ConsoleSpec{
+		OAuthSecretRotation: v1alpha1.OAuthSecretRotationPolicy{Type: v1alpha1.OAuthSecretRotationManual},
+	}}
+
+	controller, _ := newTestSecretController(existing)
+	secret, changed, _, err := controller.Sync(consoleConfig)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if changed {
+		t.Fatal("expected no rotation under a Manual policy without the rotate annotation")
+	}
+	if secretsub.GetSecretString(secret) != "current-value" {
+		t.Errorf("expected the secret value to be left alone, got %q", secretsub.GetSecretString(secret))
+	}
+}
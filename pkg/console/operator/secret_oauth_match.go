@@ -0,0 +1,17 @@
+package operator
+
+import (
+	oauthv1 "github.com/openshift/api/oauth/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	oauthsub "github.com/openshift/console-operator/pkg/console/subresource/oauthclient"
+	secretsub "github.com/openshift/console-operator/pkg/console/subresource/secret"
+)
+
+// secretAndOauthMatch reports whether the console's Secret value is the same
+// one registered against the OAuthClient.
+func secretAndOauthMatch(secret *corev1.Secret, client *oauthv1.OAuthClient) bool {
+	secretString := secretsub.GetSecretString(secret)
+	clientSecretString := oauthsub.GetSecretString(client)
+	return secretString == clientSecretString
+}
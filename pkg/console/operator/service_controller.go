@@ -0,0 +1,38 @@
+package operator
+
+import (
+	"k8s.io/klog"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/openshift/library-go/pkg/operator/resource/resourceapply"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	servicesub "github.com/openshift/console-operator/pkg/console/subresource/service"
+)
+
+// ServiceController ensures the console's Service exists and matches the
+// expected default. It has no dependencies on the other controllers.
+type ServiceController struct {
+	operator *ConsoleOperator
+}
+
+// NewServiceController returns a ServiceController bound to operator's service client.
+func NewServiceController(operator *ConsoleOperator) *ServiceController {
+	return &ServiceController{operator: operator}
+}
+
+// Sync reconciles the console Service.
+func (c *ServiceController) Sync(consoleConfig *v1alpha1.Console) (*corev1.Service, bool, controllerStatus, error) {
+	svc, svcChanged, svcErr := resourceapply.ApplyService(c.operator.serviceClient, servicesub.DefaultService(consoleConfig))
+	if svcErr != nil {
+		klog.Errorf("service: %v", svcErr)
+		return nil, false, controllerStatus{
+			conditionType: ConditionServiceDegraded,
+			degraded:      true,
+			reason:        ReasonSyncError,
+			message:       svcErr.Error(),
+		}, svcErr
+	}
+	return svc, svcChanged, controllerStatus{conditionType: ConditionServiceDegraded, degraded: false, reason: ReasonAsExpected}, nil
+}
@@ -0,0 +1,129 @@
+package operator
+
+import (
+	operatorv1alpha1 "github.com/openshift/api/operator/v1alpha1"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+)
+
+// Aggregate condition types surfaced on the Console CR. These mirror the
+// conventional ClusterOperator condition set so status can be mirrored
+// 1:1 onto the ClusterOperator object.
+const (
+	ConditionAvailable   = "Available"
+	ConditionProgressing = "Progressing"
+	ConditionDegraded    = "Degraded"
+	ConditionUpgradeable = "Upgradeable"
+)
+
+// Typed reasons used across the per-resource controllers and the aggregate
+// conditions they feed.
+const (
+	ReasonAsExpected               = "AsExpected"
+	ReasonRouteNotAdmitted         = "RouteNotAdmitted"
+	ReasonOAuthClientMissing       = "OAuthClientMissing"
+	ReasonSecretMissing            = "SecretMissing"
+	ReasonDeploymentUnavailable    = "DeploymentUnavailable"
+	ReasonConfigMapInvalid         = "ConfigMapInvalid"
+	ReasonSyncError                = "SyncError"
+	ReasonCustomRouteSecretMissing = "CustomRouteSecretMissing"
+	ReasonCustomRouteSecretInvalid = "CustomRouteSecretInvalid"
+	ReasonDriftHealed              = "SecretOAuthDriftHealed"
+)
+
+// Per-resource condition types, one per controller, folded into the
+// aggregate conditions below.
+const (
+	ConditionRouteDegraded       = "RouteDegraded"
+	ConditionServiceDegraded     = "ServiceDegraded"
+	ConditionConfigMapDegraded   = "ConfigMapDegraded"
+	ConditionSecretDegraded      = "SecretDegraded"
+	ConditionOAuthClientDegraded = "OAuthClientDegraded"
+	ConditionDeploymentDegraded  = "DeploymentDegraded"
+)
+
+// ConditionCustomRouteDegraded reports problems with the optional,
+// custom-hostname route configured via Spec.Route. Unlike the condition
+// types above, it is not folded into the aggregate Degraded/Available
+// conditions: an invalid custom route leaves the console reachable on its
+// default route, so it should not take the whole ClusterOperator down.
+const ConditionCustomRouteDegraded = "CustomRouteSyncDegraded"
+
+// ConditionSecretOAuthDrift records the console Secret and OAuthClient secret
+// falling out of sync and whether OAuthClientController's auto-heal (which
+// overwrites the OAuthClient secret with the console Secret's value, the
+// source of truth) succeeded. It is folded into the aggregate conditions
+// because a failed heal leaves the console unable to authenticate users.
+const ConditionSecretOAuthDrift = "SecretOAuthDrift"
+
+// degradedConditionTypes lists every per-resource "XDegraded" condition the
+// aggregate Degraded/Available/Progressing conditions are computed from.
+var degradedConditionTypes = []string{
+	ConditionRouteDegraded,
+	ConditionServiceDegraded,
+	ConditionConfigMapDegraded,
+	ConditionSecretDegraded,
+	ConditionOAuthClientDegraded,
+	ConditionDeploymentDegraded,
+	ConditionSecretOAuthDrift,
+}
+
+// progressingReasons lists the typed reasons that mean a sub-controller is
+// still converging on a resource it just created (not yet admitted a host,
+// not yet rolled out) rather than reporting a genuine, self-inflicted
+// failure. A per-resource condition carrying one of these drives
+// ConditionProgressing, instead of Progressing being a permanently-false
+// stub.
+var progressingReasons = map[string]bool{
+	ReasonRouteNotAdmitted:      true,
+	ReasonDeploymentUnavailable: true,
+}
+
+// computeAggregateConditions folds the per-resource XDegraded conditions
+// already present on status into the top-level Available, Progressing,
+// Degraded and Upgradeable conditions.
+func computeAggregateConditions(status *v1alpha1.ConsoleStatus) {
+	degraded, reason, message := firstDegraded(status)
+	progressing, progressingReason, progressingMessage := firstProgressing(status)
+	if !progressing {
+		progressingReason = ReasonAsExpected
+	}
+
+	setCondition(status, ConditionDegraded, degraded, reason, message)
+	setCondition(status, ConditionAvailable, !degraded, reason, message)
+	setCondition(status, ConditionProgressing, progressing, progressingReason, progressingMessage)
+	setCondition(status, ConditionUpgradeable, true, ReasonAsExpected, "")
+}
+
+// firstDegraded returns the first per-resource condition currently reporting
+// degraded, in the fixed order defined by degradedConditionTypes.
+func firstDegraded(status *v1alpha1.ConsoleStatus) (degraded bool, reason, message string) {
+	for _, conditionType := range degradedConditionTypes {
+		for _, cond := range status.Conditions {
+			if cond.Type != conditionType {
+				continue
+			}
+			if cond.Status == operatorv1alpha1.ConditionTrue {
+				return true, cond.Reason, cond.Message
+			}
+		}
+	}
+	return false, "", ""
+}
+
+// firstProgressing returns the first per-resource condition currently
+// reporting one of progressingReasons, in the same fixed order firstDegraded
+// uses.
+func firstProgressing(status *v1alpha1.ConsoleStatus) (progressing bool, reason, message string) {
+	for _, conditionType := range degradedConditionTypes {
+		for _, cond := range status.Conditions {
+			if cond.Type != conditionType {
+				continue
+			}
+			if cond.Status == operatorv1alpha1.ConditionTrue && progressingReasons[cond.Reason] {
+				return true, cond.Reason, cond.Message
+			}
+		}
+	}
+	return false, "", ""
+}
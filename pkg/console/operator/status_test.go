@@ -0,0 +1,174 @@
+package operator
+
+import (
+	"testing"
+
+	operatorv1alpha1 "github.com/openshift/api/operator/v1alpha1"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+)
+
+func conditionStatus(t *testing.T, status *v1alpha1.ConsoleStatus, conditionType string) *operatorv1alpha1.OperatorCondition {
+	t.Helper()
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == conditionType {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+func TestComputeAggregateConditions_RouteNotAdmitted(t *testing.T) {
+	status := &v1alpha1.ConsoleStatus{}
+	applyControllerStatus(status, controllerStatus{
+		conditionType: ConditionRouteDegraded,
+		degraded:      true,
+		reason:        ReasonRouteNotAdmitted,
+		message:       "waiting on Route.Spec.Host",
+	})
+
+	computeAggregateConditions(status)
+
+	degraded := conditionStatus(t, status, ConditionDegraded)
+	if degraded == nil || degraded.Status != operatorv1alpha1.ConditionTrue {
+		t.Fatalf("expected Degraded=True, got %#v", degraded)
+	}
+	if degraded.Reason != ReasonRouteNotAdmitted {
+		t.Errorf("expected reason %q, got %q", ReasonRouteNotAdmitted, degraded.Reason)
+	}
+
+	available := conditionStatus(t, status, ConditionAvailable)
+	if available == nil || available.Status != operatorv1alpha1.ConditionFalse {
+		t.Fatalf("expected Available=False while the route has no host, got %#v", available)
+	}
+}
+
+func TestComputeAggregateConditions_OAuthClientMissing(t *testing.T) {
+	status := &v1alpha1.ConsoleStatus{}
+	applyControllerStatus(status, controllerStatus{conditionType: ConditionRouteDegraded, degraded: false, reason: ReasonAsExpected})
+	applyControllerStatus(status, controllerStatus{conditionType: ConditionSecretDegraded, degraded: false, reason: ReasonAsExpected})
+	applyControllerStatus(status, controllerStatus{
+		conditionType: ConditionOAuthClientDegraded,
+		degraded:      true,
+		reason:        ReasonOAuthClientMissing,
+		message:       "oauth client for console does not exist",
+	})
+
+	computeAggregateConditions(status)
+
+	degraded := conditionStatus(t, status, ConditionDegraded)
+	if degraded == nil || degraded.Status != operatorv1alpha1.ConditionTrue || degraded.Reason != ReasonOAuthClientMissing {
+		t.Fatalf("expected Degraded=True with reason %q, got %#v", ReasonOAuthClientMissing, degraded)
+	}
+}
+
+func TestComputeAggregateConditions_SecretOAuthDriftHealFailure(t *testing.T) {
+	status := &v1alpha1.ConsoleStatus{}
+	applyControllerStatus(status, controllerStatus{
+		conditionType: ConditionSecretOAuthDrift,
+		degraded:      true,
+		reason:        ReasonSyncError,
+		message:       "failed to heal OAuthClient secret drift",
+	})
+
+	computeAggregateConditions(status)
+
+	degraded := conditionStatus(t, status, ConditionDegraded)
+	if degraded == nil || degraded.Status != operatorv1alpha1.ConditionTrue || degraded.Reason != ReasonSyncError {
+		t.Fatalf("expected Degraded=True with reason %q, got %#v", ReasonSyncError, degraded)
+	}
+}
+
+func TestComputeAggregateConditions_SecretOAuthDriftHealed(t *testing.T) {
+	status := &v1alpha1.ConsoleStatus{}
+	for _, conditionType := range degradedConditionTypes {
+		applyControllerStatus(status, controllerStatus{conditionType: conditionType, degraded: false, reason: ReasonAsExpected})
+	}
+	// a healed drift is reported as not-degraded: only a failed heal should affect Available.
+	applyControllerStatus(status, controllerStatus{
+		conditionType: ConditionSecretOAuthDrift,
+		degraded:      false,
+		reason:        ReasonDriftHealed,
+		message:       "the console Secret and OAuthClient secret had drifted; healed by overwriting the OAuthClient secret",
+	})
+
+	computeAggregateConditions(status)
+
+	available := conditionStatus(t, status, ConditionAvailable)
+	if available == nil || available.Status != operatorv1alpha1.ConditionTrue {
+		t.Fatalf("expected Available=True after a successful heal, got %#v", available)
+	}
+}
+
+func TestComputeAggregateConditions_Healthy(t *testing.T) {
+	status := &v1alpha1.ConsoleStatus{}
+	for _, conditionType := range degradedConditionTypes {
+		applyControllerStatus(status, controllerStatus{conditionType: conditionType, degraded: false, reason: ReasonAsExpected})
+	}
+
+	computeAggregateConditions(status)
+
+	degraded := conditionStatus(t, status, ConditionDegraded)
+	if degraded == nil || degraded.Status != operatorv1alpha1.ConditionFalse {
+		t.Fatalf("expected Degraded=False, got %#v", degraded)
+	}
+	available := conditionStatus(t, status, ConditionAvailable)
+	if available == nil || available.Status != operatorv1alpha1.ConditionTrue {
+		t.Fatalf("expected Available=True, got %#v", available)
+	}
+	progressing := conditionStatus(t, status, ConditionProgressing)
+	if progressing == nil || progressing.Status != operatorv1alpha1.ConditionFalse {
+		t.Fatalf("expected Progressing=False, got %#v", progressing)
+	}
+}
+
+func TestComputeAggregateConditions_ProgressingOnRouteNotAdmitted(t *testing.T) {
+	status := &v1alpha1.ConsoleStatus{}
+	applyControllerStatus(status, controllerStatus{
+		conditionType: ConditionRouteDegraded,
+		degraded:      true,
+		reason:        ReasonRouteNotAdmitted,
+		message:       "waiting on Route.Spec.Host",
+	})
+
+	computeAggregateConditions(status)
+
+	progressing := conditionStatus(t, status, ConditionProgressing)
+	if progressing == nil || progressing.Status != operatorv1alpha1.ConditionTrue || progressing.Reason != ReasonRouteNotAdmitted {
+		t.Fatalf("expected Progressing=True with reason %q, got %#v", ReasonRouteNotAdmitted, progressing)
+	}
+}
+
+func TestComputeAggregateConditions_ProgressingOnDeploymentRollout(t *testing.T) {
+	status := &v1alpha1.ConsoleStatus{}
+	applyControllerStatus(status, controllerStatus{
+		conditionType: ConditionDeploymentDegraded,
+		degraded:      true,
+		reason:        ReasonDeploymentUnavailable,
+		message:       "waiting for newly created deployment to become available",
+	})
+
+	computeAggregateConditions(status)
+
+	progressing := conditionStatus(t, status, ConditionProgressing)
+	if progressing == nil || progressing.Status != operatorv1alpha1.ConditionTrue || progressing.Reason != ReasonDeploymentUnavailable {
+		t.Fatalf("expected Progressing=True with reason %q, got %#v", ReasonDeploymentUnavailable, progressing)
+	}
+}
+
+func TestComputeAggregateConditions_GenuineFailureDoesNotReportProgressing(t *testing.T) {
+	status := &v1alpha1.ConsoleStatus{}
+	applyControllerStatus(status, controllerStatus{
+		conditionType: ConditionConfigMapDegraded,
+		degraded:      true,
+		reason:        ReasonConfigMapInvalid,
+		message:       "configmap could not be rendered",
+	})
+
+	computeAggregateConditions(status)
+
+	progressing := conditionStatus(t, status, ConditionProgressing)
+	if progressing == nil || progressing.Status != operatorv1alpha1.ConditionFalse {
+		t.Fatalf("expected a genuine failure to leave Progressing=False, got %#v", progressing)
+	}
+}
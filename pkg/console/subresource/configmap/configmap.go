@@ -0,0 +1,38 @@
+package configmap
+
+import (
+	"fmt"
+
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	"github.com/openshift/console-operator/pkg/controller"
+)
+
+const configMapName = "console-config"
+
+// DefaultConfigMap renders the console's config.yaml. consoleBaseAddress
+// prefers the custom route's host, once it has one, over the default
+// in-cluster route.
+func DefaultConfigMap(consoleConfig *v1alpha1.Console, defaultRoute *routev1.Route, customRoute *routev1.Route) *corev1.ConfigMap {
+	host := ""
+	if defaultRoute != nil {
+		host = defaultRoute.Spec.Host
+	}
+	if customRoute != nil && customRoute.Spec.Host != "" {
+		host = customRoute.Spec.Host
+	}
+
+	consoleBaseAddress := fmt.Sprintf("https://%s", host)
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      configMapName,
+			Namespace: controller.TargetNamespace,
+		},
+		Data: map[string]string{
+			"console-config.yaml": fmt.Sprintf("clusterInfo:\n  consoleBaseAddress: %s\n", consoleBaseAddress),
+		},
+	}
+}
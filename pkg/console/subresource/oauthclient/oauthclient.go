@@ -0,0 +1,86 @@
+package oauthclient
+
+import (
+	"fmt"
+	"reflect"
+
+	oauthv1 "github.com/openshift/api/oauth/v1"
+	routev1 "github.com/openshift/api/route/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	oauthclientv1 "github.com/openshift/client-go/oauth/clientset/versioned/typed/oauth/v1"
+)
+
+const oauthClientName = "console"
+
+// Stub returns an OAuthClient with just enough identity set to Get the
+// console's OAuthClient.
+func Stub() *oauthv1.OAuthClient {
+	return &oauthv1.OAuthClient{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: oauthClientName,
+		},
+	}
+}
+
+// GetSecretString returns the secret registered on client, or the empty
+// string if client is nil.
+func GetSecretString(client *oauthv1.OAuthClient) string {
+	if client == nil {
+		return ""
+	}
+	return client.Secret
+}
+
+// RegisterConsoleToOAuthClient points client's redirect URIs at every
+// admitted route passed in (the default route and, when configured, the
+// custom route) and sets client's secret.
+func RegisterConsoleToOAuthClient(client *oauthv1.OAuthClient, secret string, routes ...*routev1.Route) {
+	RegisterConsoleRedirectURIs(client, routes...)
+	client.Secret = secret
+}
+
+// RegisterConsoleRedirectURIs points client's redirect URIs at every admitted
+// route passed in (the default route and, when configured, the custom
+// route), leaving client's secret untouched. Use this when a caller needs to
+// judge whether the secret has drifted before deciding whether to overwrite
+// it.
+func RegisterConsoleRedirectURIs(client *oauthv1.OAuthClient, routes ...*routev1.Route) {
+	client.RedirectURIs = redirectURIs(routes...)
+	client.GrantMethod = oauthv1.GrantHandlerAuto
+}
+
+func redirectURIs(routes ...*routev1.Route) []string {
+	var uris []string
+	for _, rt := range routes {
+		if rt == nil || rt.Spec.Host == "" {
+			continue
+		}
+		uris = append(uris, fmt.Sprintf("https://%s/auth/callback", rt.Spec.Host))
+	}
+	return uris
+}
+
+// ApplyOAuth reconciles the live OAuthClient against required, updating its
+// secret and redirect URIs when they have drifted.
+func ApplyOAuth(client oauthclientv1.OAuthClientsGetter, required *oauthv1.OAuthClient) (*oauthv1.OAuthClient, bool, error) {
+	existing, err := client.OAuthClients().Get(required.Name, metav1.GetOptions{})
+	if err != nil {
+		return nil, false, err
+	}
+
+	if existing.Secret == required.Secret && reflect.DeepEqual(existing.RedirectURIs, required.RedirectURIs) {
+		return existing, false, nil
+	}
+
+	toUpdate := existing.DeepCopy()
+	toUpdate.Secret = required.Secret
+	toUpdate.RedirectURIs = required.RedirectURIs
+	toUpdate.GrantMethod = required.GrantMethod
+
+	updated, err := client.OAuthClients().Update(toUpdate)
+	if err != nil {
+		return nil, false, err
+	}
+	return updated, true, nil
+}
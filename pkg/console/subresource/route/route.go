@@ -0,0 +1,147 @@
+package route
+
+import (
+	"fmt"
+	"reflect"
+
+	routev1 "github.com/openshift/api/route/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	routeclientv1 "github.com/openshift/client-go/route/clientset/versioned/typed/route/v1"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	"github.com/openshift/console-operator/pkg/controller"
+)
+
+const (
+	defaultRouteName = "console"
+	customRouteName  = "console-custom"
+
+	tlsSecretCertKey = "tls.crt"
+	tlsSecretKeyKey  = "tls.key"
+)
+
+// DefaultRoute returns the console's default, operator-managed, in-cluster Route.
+func DefaultRoute(consoleConfig *v1alpha1.Console) *routev1.Route {
+	weight := int32(100)
+	return &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      defaultRouteName,
+			Namespace: controller.TargetNamespace,
+		},
+		Spec: routev1.RouteSpec{
+			To: routev1.RouteTargetReference{
+				Kind:   "Service",
+				Name:   controller.TargetName,
+				Weight: &weight,
+			},
+			Port: &routev1.RoutePort{
+				TargetPort: intstr.FromString("https"),
+			},
+			TLS: &routev1.TLSConfig{
+				Termination:                  routev1.TLSTerminationReencrypt,
+				InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+			},
+		},
+	}
+}
+
+// DefaultCustomRoute returns the custom-hostname Route described by
+// consoleConfig.Spec.Route, or nil if no custom hostname is configured.
+// tlsSecret, when non-nil, supplies the route's serving certificate; callers
+// should validate it with ValidateServingCertSecret first.
+func DefaultCustomRoute(consoleConfig *v1alpha1.Console, tlsSecret *corev1.Secret) *routev1.Route {
+	if consoleConfig.Spec.Route.Hostname == "" {
+		return nil
+	}
+
+	weight := int32(100)
+	rt := &routev1.Route{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      customRouteName,
+			Namespace: controller.TargetNamespace,
+		},
+		Spec: routev1.RouteSpec{
+			Host: consoleConfig.Spec.Route.Hostname,
+			To: routev1.RouteTargetReference{
+				Kind:   "Service",
+				Name:   controller.TargetName,
+				Weight: &weight,
+			},
+			Port: &routev1.RoutePort{
+				TargetPort: intstr.FromString("https"),
+			},
+			TLS: &routev1.TLSConfig{
+				// Reencrypt, matching DefaultRoute: the backend Service's
+				// "https" port only speaks TLS, so Edge termination (which
+				// forwards plaintext to the backend) would 50x every request.
+				Termination:                  routev1.TLSTerminationReencrypt,
+				InsecureEdgeTerminationPolicy: routev1.InsecureEdgeTerminationPolicyRedirect,
+			},
+		},
+	}
+
+	if tlsSecret != nil {
+		rt.Spec.TLS.Certificate = string(tlsSecret.Data[tlsSecretCertKey])
+		rt.Spec.TLS.Key = string(tlsSecret.Data[tlsSecretKeyKey])
+	}
+
+	return rt
+}
+
+// ValidateServingCertSecret checks that secret carries the tls.crt/tls.key
+// keys DefaultCustomRoute needs to terminate TLS for a custom hostname.
+func ValidateServingCertSecret(secret *corev1.Secret) error {
+	if secret == nil {
+		return fmt.Errorf("custom route TLS secret not found")
+	}
+	if len(secret.Data[tlsSecretCertKey]) == 0 || len(secret.Data[tlsSecretKeyKey]) == 0 {
+		return fmt.Errorf("custom route TLS secret %q is missing %q or %q", secret.Name, tlsSecretCertKey, tlsSecretKeyKey)
+	}
+	return nil
+}
+
+// GetOrCreate fetches the Route named by def, creating it from def if it does not exist yet.
+func GetOrCreate(client routeclientv1.RoutesGetter, def *routev1.Route) (*routev1.Route, bool, error) {
+	existing, err := client.Routes(def.Namespace).Get(def.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		created, createErr := client.Routes(def.Namespace).Create(def)
+		return created, true, createErr
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return existing, false, nil
+}
+
+// ApplyRoute reconciles the live Route against required, creating it if
+// absent and updating its Spec if it has drifted. Unlike GetOrCreate, this
+// keeps an already-existing Route's Spec (and, for the custom route, its TLS
+// certificate/key) current as required changes across reconciles, e.g. when
+// the custom hostname's serving-certificate secret is rotated.
+func ApplyRoute(client routeclientv1.RoutesGetter, required *routev1.Route) (*routev1.Route, bool, error) {
+	existing, err := client.Routes(required.Namespace).Get(required.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		created, createErr := client.Routes(required.Namespace).Create(required)
+		return created, true, createErr
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	if reflect.DeepEqual(existing.Spec, required.Spec) {
+		return existing, false, nil
+	}
+
+	toUpdate := existing.DeepCopy()
+	toUpdate.Spec = required.Spec
+
+	updated, err := client.Routes(required.Namespace).Update(toUpdate)
+	if err != nil {
+		return nil, false, err
+	}
+	return updated, true, nil
+}
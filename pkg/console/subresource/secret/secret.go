@@ -0,0 +1,100 @@
+package secret
+
+import (
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+	"github.com/openshift/console-operator/pkg/controller"
+)
+
+const (
+	secretName = "console-oauth-config"
+	secretKey  = "clientSecret"
+
+	// RotateAnnotation, set to "true" on the console Secret, requests an
+	// immediate rotation under an OAuthSecretRotationManual policy. SyncSecret
+	// clears it once the rotation it requested completes.
+	RotateAnnotation = "console.openshift.io/rotate-oauth-secret"
+
+	// LastRotatedAnnotation records the RFC3339 timestamp the console
+	// Secret's value was last rotated, so an OAuthSecretRotationPeriodic
+	// policy can tell when it is next due.
+	LastRotatedAnnotation = "console.openshift.io/last-rotated"
+)
+
+// Stub returns a Secret with just enough identity set to Get the console's
+// session Secret.
+func Stub() *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: controller.TargetNamespace,
+		},
+	}
+}
+
+// DefaultSecret returns the console's session Secret, carrying secretValue as
+// its OAuthClient secret.
+func DefaultSecret(consoleConfig *v1alpha1.Console, secretValue string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: controller.TargetNamespace,
+		},
+		Data: map[string][]byte{
+			secretKey: []byte(secretValue),
+		},
+	}
+}
+
+// GetSecretString returns the OAuthClient secret value carried by secret, or
+// the empty string if secret is nil or has no value set yet.
+func GetSecretString(secret *corev1.Secret) string {
+	if secret == nil {
+		return ""
+	}
+	return string(secret.Data[secretKey])
+}
+
+// ShouldRotate reports whether policy calls for SyncSecret to generate a
+// fresh secret value for secret right now.
+func ShouldRotate(secret *corev1.Secret, policy v1alpha1.OAuthSecretRotationPolicy, now time.Time) bool {
+	switch policy.Type {
+	case v1alpha1.OAuthSecretRotationManual:
+		return secret.Annotations[RotateAnnotation] == "true"
+	case v1alpha1.OAuthSecretRotationPeriodic:
+		if policy.Periodic == nil {
+			return false
+		}
+		lastRotated, err := time.Parse(time.RFC3339, secret.Annotations[LastRotatedAnnotation])
+		if err != nil {
+			// never rotated, or the annotation is unparsable: rotate now and start the clock.
+			return true
+		}
+		return now.Sub(lastRotated) >= policy.Periodic.Duration.Duration
+	default:
+		return false
+	}
+}
+
+// MarkRotated returns a copy of secret carrying secretValue as its new
+// OAuthClient secret, with its rotation-tracking annotations brought up to
+// date for now.
+func MarkRotated(secret *corev1.Secret, secretValue string, now time.Time) *corev1.Secret {
+	rotated := secret.DeepCopy()
+	if rotated.Data == nil {
+		rotated.Data = map[string][]byte{}
+	}
+	rotated.Data[secretKey] = []byte(secretValue)
+
+	if rotated.Annotations == nil {
+		rotated.Annotations = map[string]string{}
+	}
+	delete(rotated.Annotations, RotateAnnotation)
+	rotated.Annotations[LastRotatedAnnotation] = now.Format(time.RFC3339)
+
+	return rotated
+}
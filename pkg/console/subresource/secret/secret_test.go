@@ -0,0 +1,80 @@
+package secret
+
+import (
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/openshift/console-operator/pkg/apis/console/v1alpha1"
+)
+
+func TestShouldRotate_Manual(t *testing.T) {
+	policy := v1alpha1.OAuthSecretRotationPolicy{Type: v1alpha1.OAuthSecretRotationManual}
+
+	requested := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{RotateAnnotation: "true"}}}
+	if !ShouldRotate(requested, policy, time.Now()) {
+		t.Error("expected rotation when the rotate annotation is set to true")
+	}
+
+	notRequested := &corev1.Secret{}
+	if ShouldRotate(notRequested, policy, time.Now()) {
+		t.Error("expected no rotation under a Manual policy without the rotate annotation")
+	}
+}
+
+func TestShouldRotate_Periodic(t *testing.T) {
+	policy := v1alpha1.OAuthSecretRotationPolicy{
+		Type:     v1alpha1.OAuthSecretRotationPeriodic,
+		Periodic: &v1alpha1.PeriodicRotationPolicy{Duration: metav1.Duration{Duration: time.Hour}},
+	}
+	now := time.Date(2026, 7, 27, 12, 0, 0, 0, time.UTC)
+
+	neverRotated := &corev1.Secret{}
+	if !ShouldRotate(neverRotated, policy, now) {
+		t.Error("expected rotation for a secret with no last-rotated annotation")
+	}
+
+	recentlyRotated := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		LastRotatedAnnotation: now.Add(-30 * time.Minute).Format(time.RFC3339),
+	}}}
+	if ShouldRotate(recentlyRotated, policy, now) {
+		t.Error("expected no rotation before the periodic interval has elapsed")
+	}
+
+	due := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+		LastRotatedAnnotation: now.Add(-2 * time.Hour).Format(time.RFC3339),
+	}}}
+	if !ShouldRotate(due, policy, now) {
+		t.Error("expected rotation once the periodic interval has elapsed")
+	}
+}
+
+func TestShouldRotate_OnMismatchNeverRotatesProactively(t *testing.T) {
+	policy := v1alpha1.OAuthSecretRotationPolicy{Type: v1alpha1.OAuthSecretRotationOnMismatch}
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{RotateAnnotation: "true"}}}
+	if ShouldRotate(secret, policy, time.Now()) {
+		t.Error("expected OnMismatch to never trigger a proactive rotation; drift is healed by OAuthClientController instead")
+	}
+}
+
+func TestMarkRotated(t *testing.T) {
+	now := time.Date(2026, 7, 27, 12, 0, 0, 0, time.UTC)
+	secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{RotateAnnotation: "true"}}}
+
+	rotated := MarkRotated(secret, "new-value", now)
+
+	if GetSecretString(rotated) != "new-value" {
+		t.Errorf("expected rotated secret value %q, got %q", "new-value", GetSecretString(rotated))
+	}
+	if _, stillSet := rotated.Annotations[RotateAnnotation]; stillSet {
+		t.Error("expected the rotate-request annotation to be cleared after rotation")
+	}
+	if rotated.Annotations[LastRotatedAnnotation] != now.Format(time.RFC3339) {
+		t.Errorf("expected last-rotated annotation %q, got %q", now.Format(time.RFC3339), rotated.Annotations[LastRotatedAnnotation])
+	}
+	if secret.Annotations[RotateAnnotation] != "true" {
+		t.Error("MarkRotated must not mutate its input secret")
+	}
+}
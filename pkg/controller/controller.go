@@ -0,0 +1,13 @@
+package controller
+
+const (
+	// TargetNamespace is the namespace the operator manages console resources in.
+	TargetNamespace = "openshift-console"
+
+	// TargetName is the common name used for resources owned by the console operator.
+	TargetName = "console"
+
+	// OpenShiftConfigNamespace is where cluster admins place input the operator
+	// only reads, such as a custom route's serving-certificate Secret.
+	OpenShiftConfigNamespace = "openshift-config"
+)
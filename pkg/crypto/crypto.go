@@ -0,0 +1,16 @@
+package crypto
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+)
+
+// Random256BitsString returns a cryptographically random, base64-encoded
+// 256-bit string, suitable for use as an OAuth client secret.
+func Random256BitsString() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
@@ -0,0 +1,206 @@
+// Package steps provides a small sequential step runner used to replace
+// "create-and-die" reconcile loops: a Runner executes a list of Steps in
+// order, classifying the error each Action returns so that conflicts are
+// retried immediately, not-yet-visible creates are polled for, transient API
+// errors back off, and terminal errors stop the run.
+package steps
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// errConditionNotRetryable is returned internally to stop polling a Step's
+// Condition once it reports a non-retryable failure.
+var errConditionNotRetryable = errors.New("step condition failed and is not retryable")
+
+// errConflict is returned internally to break out of the transient-error
+// backoff the instant a conflict is seen, so a conflict never waits on the
+// same growing delay a transient error does.
+var errConflict = errors.New("step action returned a conflict")
+
+// Step is a single unit of reconciliation work.
+type Step struct {
+	// Name identifies the step in logs and errors, e.g. "ensureRoute".
+	Name string
+
+	// Action performs the step's work. It is retried according to the
+	// classification of the error it returns; see Runner.Run.
+	Action func(ctx context.Context) error
+
+	// Condition, if set, is polled after Action succeeds to decide whether
+	// the step is actually complete (ok), should be retried (retry), or has
+	// failed outright (err != nil). A nil Condition means Action succeeding
+	// is sufficient.
+	Condition func(ctx context.Context) (ok bool, retry bool, err error)
+
+	// Timeout bounds how long this step (Action + Condition polling) may run.
+	// Zero means no step-specific timeout.
+	Timeout time.Duration
+}
+
+// Runner executes a list of Steps in order, stopping at the first step that
+// does not complete.
+type Runner struct {
+	// MaxConflictRetries bounds how many times a conflicting write is retried
+	// before being treated as terminal. Defaults to 5 when zero.
+	MaxConflictRetries int
+
+	// Backoff governs retries of transient, non-conflict API errors.
+	// Defaults to a capped exponential backoff when unset.
+	Backoff wait.Backoff
+
+	// ConflictRetryInterval is the fixed delay between conflict retries.
+	// Conflicts are expected to resolve as soon as the other actor's write
+	// lands, so they get a short fixed delay rather than the growing
+	// transient-error backoff. Defaults to 10ms when zero.
+	ConflictRetryInterval time.Duration
+
+	// ConditionPollInterval controls how often Condition is re-checked.
+	// Defaults to 1 second when zero.
+	ConditionPollInterval time.Duration
+}
+
+func (r *Runner) maxConflictRetries() int {
+	if r.MaxConflictRetries > 0 {
+		return r.MaxConflictRetries
+	}
+	return 5
+}
+
+func (r *Runner) backoff() wait.Backoff {
+	if r.Backoff.Steps > 0 {
+		return r.Backoff
+	}
+	return wait.Backoff{
+		Duration: 100 * time.Millisecond,
+		Factor:   2.0,
+		Steps:    5,
+		Cap:      10 * time.Second,
+	}
+}
+
+func (r *Runner) conflictRetryInterval() time.Duration {
+	if r.ConflictRetryInterval > 0 {
+		return r.ConflictRetryInterval
+	}
+	return 10 * time.Millisecond
+}
+
+func (r *Runner) pollInterval() time.Duration {
+	if r.ConditionPollInterval > 0 {
+		return r.ConditionPollInterval
+	}
+	return time.Second
+}
+
+// Run executes steps in order. It returns the name of the step that failed
+// (empty on success) alongside the terminal error.
+func (r *Runner) Run(ctx context.Context, steps []Step) (string, error) {
+	for _, step := range steps {
+		stepCtx := ctx
+		cancel := func() {}
+		if step.Timeout > 0 {
+			stepCtx, cancel = context.WithTimeout(ctx, step.Timeout)
+		}
+
+		err := r.runAction(stepCtx, step)
+		if err == nil && step.Condition != nil {
+			err = r.runCondition(stepCtx, step)
+		}
+
+		cancel()
+		if err != nil {
+			return step.Name, err
+		}
+	}
+	return "", nil
+}
+
+// runAction executes step.Action, retrying conflicts immediately (bounded,
+// on their own fixed-delay schedule) and other transient errors with
+// exponential backoff. A not-found error is treated as terminal here:
+// callers express "wait for a just-created resource to become visible" via
+// Condition, not by retrying Action.
+func (r *Runner) runAction(ctx context.Context, step Step) error {
+	var lastErr error
+	conflictRetries := 0
+
+	for {
+		backoffErr := wait.ExponentialBackoff(r.backoff(), func() (bool, error) {
+			err := step.Action(ctx)
+			if err == nil {
+				return true, nil
+			}
+			lastErr = err
+
+			if apierrors.IsConflict(err) {
+				// break out of the transient backoff immediately: the
+				// outer loop below retries conflicts on their own short
+				// fixed delay, not this growing one.
+				return false, errConflict
+			}
+
+			if isTransient(err) {
+				return false, nil
+			}
+
+			// terminal error, stop retrying
+			return false, err
+		})
+
+		if backoffErr == errConflict {
+			conflictRetries++
+			if conflictRetries > r.maxConflictRetries() {
+				return lastErr
+			}
+			select {
+			case <-time.After(r.conflictRetryInterval()):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+			continue
+		}
+
+		// ExponentialBackoff returns wait.ErrWaitTimeout when it exhausts
+		// all steps without Action ever succeeding or returning a terminal
+		// error; surface the last transient error from Action in that case.
+		if backoffErr == wait.ErrWaitTimeout {
+			return lastErr
+		}
+		return backoffErr
+	}
+}
+
+// runCondition polls step.Condition until it reports ok, a non-retryable
+// failure, or the context is done.
+func (r *Runner) runCondition(ctx context.Context, step Step) error {
+	return wait.PollImmediateUntil(r.pollInterval(), func() (bool, error) {
+		ok, retry, err := step.Condition(ctx)
+		if err != nil {
+			return false, err
+		}
+		if ok {
+			return true, nil
+		}
+		if !retry {
+			return false, errConditionNotRetryable
+		}
+		return false, nil
+	}, ctx.Done())
+}
+
+// isTransient classifies API errors worth backing off and retrying rather
+// than failing the whole step immediately.
+func isTransient(err error) bool {
+	return apierrors.IsServerTimeout(err) ||
+		apierrors.IsTimeout(err) ||
+		apierrors.IsTooManyRequests(err) ||
+		apierrors.IsServiceUnavailable(err) ||
+		apierrors.IsInternalError(err)
+}
+
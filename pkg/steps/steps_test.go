@@ -0,0 +1,195 @@
+package steps
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+func testRunner() *Runner {
+	return &Runner{
+		Backoff: wait.Backoff{
+			Duration: time.Millisecond,
+			Factor:   1.5,
+			Steps:    5,
+		},
+		ConflictRetryInterval: time.Millisecond,
+		ConditionPollInterval: time.Millisecond,
+	}
+}
+
+func TestRun_AllStepsSucceed(t *testing.T) {
+	var ran []string
+	steps := []Step{
+		{Name: "a", Action: func(ctx context.Context) error { ran = append(ran, "a"); return nil }},
+		{Name: "b", Action: func(ctx context.Context) error { ran = append(ran, "b"); return nil }},
+	}
+
+	failedStep, err := testRunner().Run(context.Background(), steps)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if failedStep != "" {
+		t.Fatalf("expected no failed step, got %q", failedStep)
+	}
+	if len(ran) != 2 {
+		t.Fatalf("expected both steps to run, ran=%v", ran)
+	}
+}
+
+func TestRun_ConflictIsRetriedUntilSuccess(t *testing.T) {
+	attempts := 0
+	gr := schema.GroupResource{Resource: "deployments"}
+	steps := []Step{
+		{
+			Name: "update",
+			Action: func(ctx context.Context) error {
+				attempts++
+				if attempts < 3 {
+					return apierrors.NewConflict(gr, "console", errors.New("conflict"))
+				}
+				return nil
+			},
+		},
+	}
+
+	failedStep, err := testRunner().Run(context.Background(), steps)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if failedStep != "" {
+		t.Fatalf("expected no failed step, got %q", failedStep)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRun_ConflictRetryUsesFixedDelayNotGrowingBackoff(t *testing.T) {
+	attempts := 0
+	gr := schema.GroupResource{Resource: "deployments"}
+	runner := testRunner()
+	runner.ConflictRetryInterval = time.Millisecond
+	// 7 conflicts need more retries than the default bound of 5 allows.
+	runner.MaxConflictRetries = 10
+	// a transient-error backoff of Duration 1ms, Factor 1.5, Steps 5 would
+	// need 1+1.5+2.25+3.375+5.0625 =~ 13ms to exhaust; conflicts retrying on
+	// that same schedule for 8 attempts would take far longer than retrying
+	// on a flat 1ms delay does.
+	steps := []Step{
+		{
+			Name: "update",
+			Action: func(ctx context.Context) error {
+				attempts++
+				if attempts < 8 {
+					return apierrors.NewConflict(gr, "console", errors.New("conflict"))
+				}
+				return nil
+			},
+		},
+	}
+
+	start := time.Now()
+	failedStep, err := runner.Run(context.Background(), steps)
+	elapsed := time.Since(start)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if failedStep != "" {
+		t.Fatalf("expected no failed step, got %q", failedStep)
+	}
+	if attempts != 8 {
+		t.Fatalf("expected 8 attempts, got %d", attempts)
+	}
+	if elapsed > 50*time.Millisecond {
+		t.Fatalf("expected conflict retries to use the flat ConflictRetryInterval, not the growing transient backoff; took %s", elapsed)
+	}
+}
+
+func TestRun_ConflictExceedingMaxRetriesIsTerminal(t *testing.T) {
+	attempts := 0
+	gr := schema.GroupResource{Resource: "deployments"}
+	runner := testRunner()
+	runner.MaxConflictRetries = 2
+	conflictErr := apierrors.NewConflict(gr, "console", errors.New("conflict"))
+	steps := []Step{
+		{
+			Name: "update",
+			Action: func(ctx context.Context) error {
+				attempts++
+				return conflictErr
+			},
+		},
+	}
+
+	failedStep, err := runner.Run(context.Background(), steps)
+	if !apierrors.IsConflict(err) {
+		t.Fatalf("expected the conflict to be surfaced once retries are exhausted, got %v", err)
+	}
+	if failedStep != "update" {
+		t.Fatalf("expected failedStep=update, got %q", failedStep)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 1 initial attempt + 2 retries = 3 attempts, got %d", attempts)
+	}
+}
+
+func TestRun_TerminalErrorStopsImmediately(t *testing.T) {
+	attempts := 0
+	terminal := errors.New("boom")
+	steps := []Step{
+		{
+			Name: "ensureDeployment",
+			Action: func(ctx context.Context) error {
+				attempts++
+				return terminal
+			},
+		},
+		{
+			Name:   "neverRuns",
+			Action: func(ctx context.Context) error { t.Fatal("should not run a step after a terminal failure"); return nil },
+		},
+	}
+
+	failedStep, err := testRunner().Run(context.Background(), steps)
+	if err != terminal {
+		t.Fatalf("expected terminal error to be returned, got %v", err)
+	}
+	if failedStep != "ensureDeployment" {
+		t.Fatalf("expected failedStep=ensureDeployment, got %q", failedStep)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected exactly 1 attempt for a terminal error, got %d", attempts)
+	}
+}
+
+func TestRun_ConditionPollsUntilReady(t *testing.T) {
+	checks := 0
+	steps := []Step{
+		{
+			Name:   "waitForRouteHost",
+			Action: func(ctx context.Context) error { return nil },
+			Condition: func(ctx context.Context) (bool, bool, error) {
+				checks++
+				return checks >= 3, true, nil
+			},
+		},
+	}
+
+	failedStep, err := testRunner().Run(context.Background(), steps)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if failedStep != "" {
+		t.Fatalf("expected no failed step, got %q", failedStep)
+	}
+	if checks < 3 {
+		t.Fatalf("expected condition to be polled at least 3 times, got %d", checks)
+	}
+}